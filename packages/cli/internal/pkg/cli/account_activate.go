@@ -4,8 +4,13 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/aws/amazon-genomics-cli/cli/environment"
 	"github.com/aws/amazon-genomics-cli/cli/internal/pkg/cli/clierror"
@@ -20,46 +25,208 @@ import (
 )
 
 const (
-	accountBucketFlag            = "bucket"
-	accountVpcFlag               = "vpc"
-	accountBucketFlagDescription = `The name of an S3 bucket that AGC will use to store its data.
+	accountBucketFlag                 = "bucket"
+	accountVpcFlag                    = "vpc"
+	accountRequireAwsFlag             = "require-aws"
+	accountMirrorImagesFlag           = "mirror-images"
+	accountBucketExpireDaysFlag       = "bucket-expire-days"
+	accountBucketTransitionIADaysFlag = "bucket-transition-ia-days"
+	accountBucketContextFlag          = "bucket-context"
+	accountBucketFlagDescription      = `The name of an S3 bucket that AGC will use to store its data.
 An autogenerated name will be used if not specified. A new bucket will be created if the bucket does not exist.`
-	accountVpcFlagDescription = `The ID of a VPC that AGC will run in. 
+	accountVpcFlagDescription = `The ID of a VPC that AGC will run in.
 A new VPC will be created if not specified.`
-	cdkCoreDir   = ".agc/cdk/apps/core"
-	bucketPrefix = "agc"
+	accountRequireAwsFlagDescription = `Run a preflight check of AWS credentials and permissions before activating,
+and abort with a descriptive error instead of attempting deployment.`
+	accountMirrorImagesFlagDescription = `Mirror the AGC WES/Cromwell/Nextflow images into this account's own ECR
+repositories instead of pulling them from Amazon's account. Use this in air-gapped
+accounts or regions where AGC has not published images.`
+	accountBucketExpireDaysDescription = `Number of days after which AGC-managed bucket objects are expired.
+Only applied to a bucket AGC creates when --bucket is not given; ignored if --bucket is given
+or an AGC bucket already exists.`
+	accountBucketTransitionIADaysDescription = `Number of days after which AGC-managed bucket objects are
+transitioned to S3 Infrequent Access. Only applied to a bucket AGC creates when --bucket is not
+given; ignored if --bucket is given or an AGC bucket already exists.`
+	accountBucketContextFlagDescription = `Provision (or reuse) a bucket dedicated to this context name instead of
+the one shared AGC bucket. Useful for shared/CI accounts where teams should not need cross-team
+bucket permissions. Ignored if --bucket is given.`
+	accountAssumeRoleArnFlag = "assume-role-arn"
+	accountExternalIdFlag    = "external-id"
+	accountDurationFlag      = "duration"
+	accountMfaSerialFlag     = "mfa-serial"
+	accountAssumeRoleArnFlagDescription = `The ARN of a role to assume (on top of --profile) before activating.
+Useful when your SSO/profile credentials only grant sts:AssumeRole into the target account.`
+	accountExternalIdFlagDescription = `The external ID to pass to sts:AssumeRole. Only used with --assume-role-arn.`
+	accountDurationFlagDescription   = `How long the assumed role session should last. Only used with --assume-role-arn.`
+	accountMfaSerialFlagDescription  = `The serial number or ARN of the MFA device to use when assuming a role that
+requires MFA. Only used with --assume-role-arn.`
+	accountOutputFlag                = "output"
+	accountOutputFlagDescription      = `The format activation progress is reported in: 'text' (default, a spinner),
+'json', or 'ndjson' (one JSON record per CDK resource event, plus a final summary record). The
+structured formats let CI/CD pipelines consume activation events instead of scraping console output.`
+	outputFormatText   = "text"
+	outputFormatJSON   = "json"
+	outputFormatNDJSON = "ndjson"
+	accountDryRunFlag             = "dry-run"
+	accountShowDiffFlag           = "show-diff"
+	accountDryRunFlagDescription   = `Print the resources that would be created/modified/destroyed and any drift
+from the last successful activation, without touching AWS. Implies --show-diff.`
+	accountShowDiffFlagDescription = `Print the resources that would be created/modified/destroyed before deploying.`
+	cdkCoreDir = ".agc/cdk/apps/core"
+)
+
+// preflightCategory classifies a preflight failure so the user sees an actionable suggestion
+// instead of a raw AWS SDK error.
+type preflightCategory string
+
+const (
+	preflightAuthCategory       preflightCategory = "auth"
+	preflightPermissionCategory preflightCategory = "permission"
+	preflightNetworkCategory    preflightCategory = "network"
 )
 
 type accountActivateVars struct {
-	bucketName string
-	vpcId      string
+	bucketName           string
+	vpcId                string
+	requireAws           bool
+	mirrorImages         bool
+	bucketExpireDays     int
+	bucketTransitionDays int
+	bucketContext        string
+	assumeRoleArn        string
+	externalId           string
+	mfaSerial            string
+	duration             time.Duration
+	output               string
+	dryRun               bool
+	showDiff             bool
+}
+
+// activationProgressRecord is one CDK resource event rendered as a structured, machine-readable
+// record for --output=json/ndjson.
+type activationProgressRecord struct {
+	Timestamp    string `json:"timestamp"`
+	Stack        string `json:"stack"`
+	ResourceType string `json:"resource_type"`
+	LogicalId    string `json:"logical_id"`
+	Status       string `json:"status"`
+	PhysicalId   string `json:"physical_id,omitempty"`
+	DurationMs   int64  `json:"duration_ms"`
+	Error        string `json:"error,omitempty"`
+}
+
+// activationSummaryRecord is the final record emitted for --output=json/ndjson once activation
+// completes, describing the resources it actually used or created.
+type activationSummaryRecord struct {
+	BucketArn    string            `json:"bucket_arn"`
+	VpcId        string            `json:"vpc_id,omitempty"`
+	KmsKeyArn    string            `json:"kms_key_arn,omitempty"`
+	ImageDigests map[string]string `json:"image_digests,omitempty"`
 }
 
 type accountActivateOpts struct {
 	accountActivateVars
-	stsClient sts.Interface
-	s3Client  s3.Interface
-	cdkClient cdk.Interface
-	ecrClient ecr.Interface
-	imageRefs map[string]ecr.ImageReference
-	region    string
+	stsClient         sts.Interface
+	s3Client          s3.Interface
+	cdkClient         cdk.Interface
+	ecrClient         ecr.Interface
+	bucketProvisioner s3.BucketProvisioner
+	imageRefs         map[string]ecr.ImageReference
+	region            string
 }
 
 func newAccountActivateOpts(vars accountActivateVars) (*accountActivateOpts, error) {
+	if err := validateOutputFormat(vars.output); err != nil {
+		return nil, err
+	}
+
 	imageRefs := environment.CommonImages
+
+	// profile is the persistent --profile flag inherited from the root command; account activate
+	// does not redefine its own --profile, so there is exactly one flag and one variable behind it.
+	resolvedProfile := profile
+
+	// Every activation - not just --assume-role-arn ones - goes through the CredentialResolver,
+	// so env/shared-file/SSO-cache/IMDS credentials and an optional assumed role are all
+	// resolved the same way instead of SSO-only activations relying solely on whatever
+	// aws.XClient(profile) does internally.
+	if err := resolveCredentialsIntoEnvironment(resolvedProfile, vars); err != nil {
+		return nil, err
+	}
+	// The SDK default chain now picks up what the resolver found from the environment, so
+	// downstream clients no longer need a named profile.
+	resolvedProfile = ""
+
+	s3Client := aws.S3Client(resolvedProfile)
 	return &accountActivateOpts{
 		accountActivateVars: vars,
 		imageRefs:           imageRefs,
-		stsClient:           aws.StsClient(profile),
-		s3Client:            aws.S3Client(profile),
-		cdkClient:           cdk.NewClient(profile),
-		ecrClient:           aws.EcrClient(profile),
-		region:              aws.Region(profile),
+		stsClient:           aws.StsClient(resolvedProfile),
+		s3Client:            s3Client,
+		cdkClient:           cdk.NewClient(resolvedProfile),
+		ecrClient:           aws.EcrClient(resolvedProfile),
+		bucketProvisioner:   s3.NewBucketProvisioner(s3Client),
+		region:              aws.Region(resolvedProfile),
 	}, nil
 }
 
+// validateOutputFormat rejects an --output value other than the documented set, instead of
+// letting an unrecognized value silently fall through to the text spinner.
+func validateOutputFormat(output string) error {
+	switch output {
+	case outputFormatText, outputFormatJSON, outputFormatNDJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid --output value '%s': must be one of 'text', 'json', 'ndjson'", output)
+	}
+}
+
+// resolveCredentialsIntoEnvironment resolves credentials for baseProfile (and, if set,
+// vars.assumeRoleArn) through a CredentialResolver and exports them as AWS_* environment
+// variables, so every client built from an empty profile picks up exactly what was resolved.
+func resolveCredentialsIntoEnvironment(baseProfile string, vars accountActivateVars) error {
+	resolver := aws.NewCredentialResolver(aws.CredentialOptions{
+		Profile:       baseProfile,
+		AssumeRoleArn: vars.assumeRoleArn,
+		ExternalId:    vars.externalId,
+		MfaSerial:     vars.mfaSerial,
+		Duration:      vars.duration,
+	})
+
+	creds, region, err := resolver.Credentials(context.Background())
+	if err != nil {
+		return err
+	}
+
+	if err := os.Setenv("AWS_ACCESS_KEY_ID", creds.AccessKeyID); err != nil {
+		return err
+	}
+	if err := os.Setenv("AWS_SECRET_ACCESS_KEY", creds.SecretAccessKey); err != nil {
+		return err
+	}
+	if err := os.Setenv("AWS_SESSION_TOKEN", creds.SessionToken); err != nil {
+		return err
+	}
+	if region != "" {
+		return os.Setenv("AWS_DEFAULT_REGION", region)
+	}
+	return nil
+}
+
 // Execute activates AGC.
 func (o *accountActivateOpts) Execute() error {
+	if o.requireAws {
+		if err := o.preflightCheck(); err != nil {
+			return err
+		}
+	}
+
+	// --dry-run must return before any provisioning or mirroring side effect runs, so it is
+	// handled as its own path rather than as a late check after AWS has already been touched.
+	if o.dryRun {
+		return o.renderDryRun()
+	}
+
 	if o.bucketName == "" {
 		bucketName, err := o.generateDefaultBucket()
 		if err != nil {
@@ -73,15 +240,37 @@ func (o *accountActivateOpts) Execute() error {
 		return err
 	}
 
-	for _, imageRef := range o.imageRefs {
-		if err := o.ecrClient.VerifyImageExists(imageRef); err != nil {
+	if o.mirrorImages {
+		mirrored, err := ecr.NewMirror(o.ecrClient).MirrorImages(o.imageRefs)
+		if err != nil {
+			return err
+		}
+		o.imageRefs = mirrored
+	} else {
+		for _, imageRef := range o.imageRefs {
+			if err := o.ecrClient.VerifyImageExists(imageRef); err != nil {
+				return err
+			}
+		}
+	}
+
+	environmentVars := o.buildEnvironmentVars(o.bucketName, !exists)
+
+	if o.showDiff {
+		if err := o.renderDiff(environmentVars); err != nil {
 			return err
 		}
 	}
 
+	return o.deployCoreInfrastructure(environmentVars)
+}
+
+// buildEnvironmentVars assembles the CDK environment variables that describe the bucket and
+// ECR images account activate deploys against, from o.imageRefs as they currently stand.
+func (o accountActivateOpts) buildEnvironmentVars(bucketName string, createBucket bool) []string {
 	environmentVars := []string{
-		fmt.Sprintf("AGC_BUCKET_NAME=%s", o.bucketName),
-		fmt.Sprintf("CREATE_AGC_BUCKET=%t", !exists),
+		fmt.Sprintf("AGC_BUCKET_NAME=%s", bucketName),
+		fmt.Sprintf("CREATE_AGC_BUCKET=%t", createBucket),
 
 		fmt.Sprintf("ECR_WES_ACCOUNT_ID=%s", o.imageRefs[environment.WesImageKey].RegistryId),
 		fmt.Sprintf("ECR_WES_REGION=%s", o.imageRefs[environment.WesImageKey].Region),
@@ -101,8 +290,133 @@ func (o *accountActivateOpts) Execute() error {
 	if o.vpcId != "" {
 		environmentVars = append(environmentVars, fmt.Sprintf("VPC_ID=%s", o.vpcId))
 	}
+	return environmentVars
+}
 
-	return o.deployCoreInfrastructure(environmentVars)
+// renderDryRun prints the resources account activate would create/modify/destroy, plus any
+// drift from the account's last successful activation, without creating the S3 bucket, mirroring
+// any images, or making any other AWS change. --show-diff without --dry-run instead renders the
+// same diff after the real bucket/mirroring side effects, against what was actually provisioned.
+func (o accountActivateOpts) renderDryRun() error {
+	bucketName := o.bucketName
+	createBucket := true
+	if bucketName == "" {
+		account, err := o.stsClient.GetAccount()
+		if err != nil {
+			return err
+		}
+		bucketName = s3.DefaultBucketName(account, o.region)
+		if o.bucketContext != "" {
+			bucketName = fmt.Sprintf("%s-%s", bucketName, o.bucketContext)
+		}
+	} else {
+		exists, err := o.s3Client.BucketExists(bucketName)
+		if err != nil {
+			return err
+		}
+		createBucket = !exists
+	}
+
+	if o.mirrorImages {
+		log.Info().Msg("--mirror-images was requested; --dry-run reports against the AGC-published images since no mirror repository is created")
+	}
+
+	return o.renderDiff(o.buildEnvironmentVars(bucketName, createBucket))
+}
+
+// renderDiff prints the resources account activate would create/modify/destroy, plus any drift
+// from the account's last successful activation, without making any AWS changes. It is used for
+// both --dry-run and --show-diff.
+func (o accountActivateOpts) renderDiff(environmentVars []string) error {
+	homeDir, err := DetermineHomeDir()
+	if err != nil {
+		return err
+	}
+
+	account, err := o.stsClient.GetAccount()
+	if err != nil {
+		return err
+	}
+
+	cdkAppPath := filepath.Join(homeDir, cdkCoreDir)
+	diff, err := o.cdkClient.DiffApp(cdkAppPath, environmentVars)
+	if err != nil {
+		return err
+	}
+	log.Info().Msg(diff.String())
+
+	previous, err := loadActivationState(homeDir, account, o.region)
+	if err != nil {
+		return err
+	}
+	if previous != nil && previous.TemplateHash != diff.TemplateHash {
+		log.Warn().Msgf("Detected drift from the last successful activation of %s/%s: template has changed since state was last recorded", account, o.region)
+	}
+
+	return nil
+}
+
+// preflightDeps is the subset of AWS behavior preflightCheck needs, kept as plain funcs rather
+// than the full sts/s3/ecr/cdk interfaces so runPreflightCheck can be unit-tested with fakes.
+type preflightDeps struct {
+	getAccount        func() (string, error)
+	listBuckets       func() ([]string, error)
+	verifyImageExists func(ecr.ImageReference) error
+	isBootstrapped    func(region string) (bool, error)
+}
+
+// preflightCheck confirms AWS credentials are usable and the caller has the permissions
+// activation depends on, before any deployment side-effect runs. It fails fast with a
+// categorized error instead of letting a half-activated account surface as a CDK failure.
+func (o *accountActivateOpts) preflightCheck() error {
+	deps := preflightDeps{
+		getAccount:        o.stsClient.GetAccount,
+		listBuckets:       o.s3Client.ListBuckets,
+		verifyImageExists: o.ecrClient.VerifyImageExists,
+		isBootstrapped:    o.cdkClient.IsBootstrapped,
+	}
+	return runPreflightCheck(deps, o.imageRefs, o.region, o.preflightError)
+}
+
+// runPreflightCheck is the pure logic behind preflightCheck. It probes the caller's own
+// permissions (ListBuckets, not a HeadBucket of a bucket the caller may not own) so a 403 here
+// reliably means the caller lacks S3 permissions rather than merely not owning a global name.
+func runPreflightCheck(deps preflightDeps, imageRefs map[string]ecr.ImageReference, region string, wrapErr func(preflightCategory, string, error) error) error {
+	if _, err := deps.getAccount(); err != nil {
+		return wrapErr(preflightAuthCategory, "verify caller identity", err)
+	}
+
+	if _, err := deps.listBuckets(); err != nil {
+		return wrapErr(preflightPermissionCategory, "list S3 buckets", err)
+	}
+
+	for _, imageRef := range imageRefs {
+		if err := deps.verifyImageExists(imageRef); err != nil {
+			return wrapErr(preflightNetworkCategory, fmt.Sprintf("reach ECR repository %s", imageRef.RepositoryName), err)
+		}
+	}
+
+	if bootstrapped, err := deps.isBootstrapped(region); err != nil {
+		return wrapErr(preflightNetworkCategory, "check CDK bootstrap status", err)
+	} else if !bootstrapped {
+		return wrapErr(preflightPermissionCategory, "check CDK bootstrap status",
+			fmt.Errorf("region %s has not been bootstrapped for CDK", region))
+	}
+
+	return nil
+}
+
+func (o *accountActivateOpts) preflightError(category preflightCategory, step string, err error) error {
+	var suggestion string
+	switch category {
+	case preflightAuthCategory:
+		suggestion = "check your AWS credentials are present and not expired (run `aws sso login` if using SSO)"
+	case preflightPermissionCategory:
+		suggestion = "check your AWS user/role has the permissions required to activate AGC"
+	case preflightNetworkCategory:
+		suggestion = "check your network/VPC can reach AWS and that the region matches your credentials"
+	}
+	return clierror.New(fmt.Sprintf("account activate preflight: %s", step), o.accountActivateVars, err, suggestion)
 }
 
 func (o accountActivateOpts) generateDefaultBucket() (string, error) {
@@ -110,7 +424,14 @@ func (o accountActivateOpts) generateDefaultBucket() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return generateBucketName(account, o.region), nil
+
+	// o.bucketContext is empty by default, giving the one shared bucket; --bucket-context scopes
+	// the bucket to a single context name instead, for shared/CI accounts that don't want to
+	// grant cross-team bucket permissions.
+	return o.bucketProvisioner.Provision(account, o.region, o.bucketContext, s3.LifecyclePolicy{
+		ExpireDays:       o.bucketExpireDays,
+		TransitionIADays: o.bucketTransitionDays,
+	})
 }
 
 func (o accountActivateOpts) deployCoreInfrastructure(environmentVars []string) error {
@@ -124,6 +445,11 @@ func (o accountActivateOpts) deployCoreInfrastructure(environmentVars []string)
 	if err != nil {
 		return err
 	}
+
+	if o.output == outputFormatJSON || o.output == outputFormatNDJSON {
+		return o.streamStructuredProgress(homeDir, progressStream)
+	}
+
 	if logging.Verbose {
 		var lastEvent cdk.ProgressEvent
 		for event := range progressStream {
@@ -135,10 +461,206 @@ func (o accountActivateOpts) deployCoreInfrastructure(environmentVars []string)
 			}
 			lastEvent = event
 		}
-	} else {
-		return progressStream.DisplayProgress("Activating account...")
+		return o.persistActivationState(homeDir, lastEvent)
 	}
-	return nil
+
+	// DisplayProgress drains progressStream itself, so it is teed through a forwarding
+	// goroutine that also captures the last event - this lets the default text/spinner path
+	// persist state for drift detection too, matching the --verbose and structured-output paths.
+	// If DisplayProgress returns early (e.g. on a CDK error) it stops reading teed, so the
+	// forwarding goroutine selects on done instead of blocking forever on `teed <- event`; once
+	// signaled it keeps draining progressStream without forwarding, so the CDK subprocess isn't
+	// left blocked writing to a channel nobody reads.
+	done := make(chan struct{})
+	teed := make(cdk.ProgressStream)
+	var lastEvent cdk.ProgressEvent
+	go func() {
+		defer close(teed)
+		for event := range progressStream {
+			lastEvent = event
+			select {
+			case teed <- event:
+			case <-done:
+				for range progressStream {
+				}
+				return
+			}
+		}
+	}()
+
+	err = teed.DisplayProgress("Activating account...")
+	close(done)
+	if err != nil {
+		return err
+	}
+	return o.persistActivationState(homeDir, lastEvent)
+}
+
+// persistActivationState records the deployed stack's outputs and template hash under
+// ~/.agc/state, so a later `account activate --dry-run`/`--show-diff` can detect drift.
+func (o accountActivateOpts) persistActivationState(homeDir string, lastEvent cdk.ProgressEvent) error {
+	account, err := o.stsClient.GetAccount()
+	if err != nil {
+		return err
+	}
+
+	outputs := map[string]string{}
+	for _, line := range lastEvent.Outputs {
+		key, value, found := strings.Cut(line, "=")
+		if found {
+			outputs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return saveActivationState(homeDir, account, o.region, activationState{
+		StackOutputs: outputs,
+		TemplateHash: lastEvent.TemplateHash,
+	})
+}
+
+// structuredActivationDocument is the single JSON document emitted for --output=json once
+// activation completes, bundling every progress record alongside the final summary. --output=ndjson
+// instead streams each activationProgressRecord as its own line, followed by a final
+// activationSummaryRecord line, so a consumer can react as events happen.
+type structuredActivationDocument struct {
+	Events  []activationProgressRecord `json:"events"`
+	Summary activationSummaryRecord    `json:"summary"`
+}
+
+// streamStructuredProgress renders activation progress for --output=json/ndjson, so CI/CD
+// pipelines and orchestrators can consume activation events programmatically instead of scraping
+// console output.
+func (o accountActivateOpts) streamStructuredProgress(homeDir string, progressStream cdk.ProgressStream) error {
+	if o.output == outputFormatJSON {
+		return o.renderJSONProgress(homeDir, progressStream)
+	}
+	return o.streamNDJSONProgress(homeDir, progressStream)
+}
+
+// streamNDJSONProgress writes one activationProgressRecord per line as events happen, followed by
+// a final activationSummaryRecord line.
+func (o accountActivateOpts) streamNDJSONProgress(homeDir string, progressStream cdk.ProgressStream) error {
+	encoder := json.NewEncoder(os.Stdout)
+	var lastEvent cdk.ProgressEvent
+
+	for event := range progressStream {
+		if err := encoder.Encode(progressRecordFromEvent(event)); err != nil {
+			return err
+		}
+		if event.Err != nil {
+			return event.Err
+		}
+		lastEvent = event
+	}
+
+	summary, err := o.summarizeActivation(lastEvent)
+	if err != nil {
+		return err
+	}
+	if err := encoder.Encode(summary); err != nil {
+		return err
+	}
+	return o.persistActivationState(homeDir, lastEvent)
+}
+
+// renderJSONProgress buffers every event and writes a single structuredActivationDocument once
+// activation completes (or fails), instead of streaming one line per event.
+func (o accountActivateOpts) renderJSONProgress(homeDir string, progressStream cdk.ProgressStream) error {
+	var lastEvent cdk.ProgressEvent
+	var events []activationProgressRecord
+
+	for event := range progressStream {
+		events = append(events, progressRecordFromEvent(event))
+		if event.Err != nil {
+			_ = json.NewEncoder(os.Stdout).Encode(structuredActivationDocument{Events: events})
+			return event.Err
+		}
+		lastEvent = event
+	}
+
+	summary, err := o.summarizeActivation(lastEvent)
+	if err != nil {
+		return err
+	}
+	document := structuredActivationDocument{
+		Events:  events,
+		Summary: summary,
+	}
+	if err := json.NewEncoder(os.Stdout).Encode(document); err != nil {
+		return err
+	}
+	return o.persistActivationState(homeDir, lastEvent)
+}
+
+func progressRecordFromEvent(event cdk.ProgressEvent) activationProgressRecord {
+	record := activationProgressRecord{
+		Timestamp:    event.Timestamp,
+		Stack:        event.StackName,
+		ResourceType: event.ResourceType,
+		LogicalId:    event.LogicalId,
+		Status:       event.Status,
+		PhysicalId:   event.PhysicalId,
+		DurationMs:   event.Duration.Milliseconds(),
+	}
+	if event.Err != nil {
+		record.Error = event.Err.Error()
+	}
+	return record
+}
+
+// summarizeActivation extracts the resources activation actually used or created from the final
+// CDK stack outputs, falling back to what Execute already knows when a given output isn't found.
+func (o accountActivateOpts) summarizeActivation(lastEvent cdk.ProgressEvent) (activationSummaryRecord, error) {
+	outputs := map[string]string{}
+	for _, line := range lastEvent.Outputs {
+		key, value, found := strings.Cut(line, "=")
+		if found {
+			outputs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	imageDigests, err := o.resolveImageDigests()
+	if err != nil {
+		return activationSummaryRecord{}, err
+	}
+
+	summary := activationSummaryRecord{
+		BucketArn:    fmt.Sprintf("arn:aws:s3:::%s", o.bucketName),
+		VpcId:        o.vpcId,
+		ImageDigests: imageDigests,
+	}
+	if bucketArn, ok := outputs["BucketArn"]; ok {
+		summary.BucketArn = bucketArn
+	}
+	if vpcId, ok := outputs["VpcId"]; ok {
+		summary.VpcId = vpcId
+	}
+	if kmsKeyArn, ok := outputs["KmsKeyArn"]; ok {
+		summary.KmsKeyArn = kmsKeyArn
+	}
+	return summary, nil
+}
+
+// resolveImageDigests looks up the content digest ECR assigned to the image actually used for
+// each image key, so the activation summary reports what was deployed rather than a mutable tag.
+func (o accountActivateOpts) resolveImageDigests() (map[string]string, error) {
+	return resolveImageDigests(o.imageRefs, o.ecrClient.DescribeImageDigest)
+}
+
+// describeImageDigestFn is the subset of ECR behavior resolveImageDigests needs, kept as a plain
+// func (rather than the full ecr.Interface) so resolveImageDigests can be unit-tested with a fake.
+type describeImageDigestFn func(repositoryName, imageTag string) (string, error)
+
+func resolveImageDigests(imageRefs map[string]ecr.ImageReference, describe describeImageDigestFn) (map[string]string, error) {
+	digests := make(map[string]string, len(imageRefs))
+	for key, imageRef := range imageRefs {
+		digest, err := describe(imageRef.RepositoryName, imageRef.ImageTag)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve image digest for %s:%s: %w", imageRef.RepositoryName, imageRef.ImageTag, err)
+		}
+		digests[key] = digest
+	}
+	return digests, nil
 }
 
 // BuildAccountActivateCommand builds the command for activating AGC in an AWS account.
@@ -168,5 +690,17 @@ Activate AGC in your AWS account with a custom S3 bucket and VPC.
 	}
 	cmd.Flags().StringVar(&vars.bucketName, accountBucketFlag, "", accountBucketFlagDescription)
 	cmd.Flags().StringVar(&vars.vpcId, accountVpcFlag, "", accountVpcFlagDescription)
+	cmd.Flags().BoolVar(&vars.requireAws, accountRequireAwsFlag, false, accountRequireAwsFlagDescription)
+	cmd.Flags().BoolVar(&vars.mirrorImages, accountMirrorImagesFlag, false, accountMirrorImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.bucketExpireDays, accountBucketExpireDaysFlag, 0, accountBucketExpireDaysDescription)
+	cmd.Flags().IntVar(&vars.bucketTransitionDays, accountBucketTransitionIADaysFlag, 0, accountBucketTransitionIADaysDescription)
+	cmd.Flags().StringVar(&vars.bucketContext, accountBucketContextFlag, "", accountBucketContextFlagDescription)
+	cmd.Flags().StringVar(&vars.assumeRoleArn, accountAssumeRoleArnFlag, "", accountAssumeRoleArnFlagDescription)
+	cmd.Flags().StringVar(&vars.externalId, accountExternalIdFlag, "", accountExternalIdFlagDescription)
+	cmd.Flags().StringVar(&vars.mfaSerial, accountMfaSerialFlag, "", accountMfaSerialFlagDescription)
+	cmd.Flags().DurationVar(&vars.duration, accountDurationFlag, 0, accountDurationFlagDescription)
+	cmd.Flags().StringVar(&vars.output, accountOutputFlag, outputFormatText, accountOutputFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, accountDryRunFlag, false, accountDryRunFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, accountShowDiffFlag, false, accountShowDiffFlagDescription)
 	return cmd
 }
@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/cli/environment"
+	"github.com/aws/amazon-genomics-cli/common/aws/ecr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOutputFormat_RejectsUnknownValue(t *testing.T) {
+	require.Error(t, validateOutputFormat("yaml"))
+}
+
+func TestValidateOutputFormat_AcceptsKnownValues(t *testing.T) {
+	for _, format := range []string{outputFormatText, outputFormatJSON, outputFormatNDJSON} {
+		assert.NoError(t, validateOutputFormat(format), "format %q should be accepted", format)
+	}
+}
+
+func TestResolveImageDigests_ResolvesEachImageByRepositoryAndTag(t *testing.T) {
+	imageRefs := map[string]ecr.ImageReference{
+		environment.WesImageKey:      {RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+		environment.CromwellImageKey: {RepositoryName: "agc-cromwell", ImageTag: "4.5.6"},
+	}
+	describe := func(repositoryName, imageTag string) (string, error) {
+		return "sha256:" + repositoryName + "@" + imageTag, nil
+	}
+
+	digests, err := resolveImageDigests(imageRefs, describe)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:agc-wes@1.2.3", digests[environment.WesImageKey])
+	assert.Equal(t, "sha256:agc-cromwell@4.5.6", digests[environment.CromwellImageKey])
+}
+
+func TestResolveImageDigests_ReturnsErrorWhenLookupFails(t *testing.T) {
+	imageRefs := map[string]ecr.ImageReference{
+		environment.WesImageKey: {RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+	}
+	describe := func(repositoryName, imageTag string) (string, error) {
+		return "", errors.New("ImageNotFoundException")
+	}
+
+	_, err := resolveImageDigests(imageRefs, describe)
+
+	require.Error(t, err)
+}
@@ -0,0 +1,73 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/amazon-genomics-cli/cli/environment"
+	"github.com/aws/amazon-genomics-cli/common/aws/ecr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fakePreflightDeps() preflightDeps {
+	return preflightDeps{
+		getAccount:        func() (string, error) { return "123456789012", nil },
+		listBuckets:       func() ([]string, error) { return nil, nil },
+		verifyImageExists: func(ecr.ImageReference) error { return nil },
+		isBootstrapped:    func(string) (bool, error) { return true, nil },
+	}
+}
+
+func TestRunPreflightCheck_Success(t *testing.T) {
+	err := runPreflightCheck(fakePreflightDeps(), environment.CommonImages, "us-east-1", func(_ preflightCategory, step string, err error) error {
+		t.Fatalf("unexpected preflight failure at %s: %v", step, err)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestRunPreflightCheck_AuthFailure(t *testing.T) {
+	deps := fakePreflightDeps()
+	deps.getAccount = func() (string, error) { return "", errors.New("expired token") }
+
+	var gotCategory preflightCategory
+	err := runPreflightCheck(deps, environment.CommonImages, "us-east-1", func(category preflightCategory, step string, err error) error {
+		gotCategory = category
+		return err
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, preflightAuthCategory, gotCategory)
+}
+
+func TestRunPreflightCheck_PermissionFailureOnListBuckets(t *testing.T) {
+	deps := fakePreflightDeps()
+	deps.listBuckets = func() ([]string, error) { return nil, errors.New("AccessDenied") }
+
+	var gotCategory preflightCategory
+	err := runPreflightCheck(deps, environment.CommonImages, "us-east-1", func(category preflightCategory, step string, err error) error {
+		gotCategory = category
+		return err
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, preflightPermissionCategory, gotCategory)
+}
+
+func TestRunPreflightCheck_NotBootstrapped(t *testing.T) {
+	deps := fakePreflightDeps()
+	deps.isBootstrapped = func(string) (bool, error) { return false, nil }
+
+	var gotCategory preflightCategory
+	err := runPreflightCheck(deps, environment.CommonImages, "us-east-1", func(category preflightCategory, step string, err error) error {
+		gotCategory = category
+		return err
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, preflightPermissionCategory, gotCategory)
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const activationStateDir = ".agc/state"
+
+// activationState is what account activate persists about its last successful deployment, so a
+// later --dry-run/--show-diff can detect drift by comparing its template hash with the one
+// `cdk diff` reports now.
+type activationState struct {
+	StackOutputs map[string]string `json:"stack_outputs"`
+	TemplateHash string            `json:"template_hash"`
+}
+
+func activationStatePath(homeDir, account, region string) string {
+	return filepath.Join(homeDir, activationStateDir, fmt.Sprintf("%s-%s.json", account, region))
+}
+
+// loadActivationState reads the persisted state for account/region, if any. It is not an error
+// for no state to exist yet; callers get a nil state and treat everything as new.
+func loadActivationState(homeDir, account, region string) (*activationState, error) {
+	data, err := os.ReadFile(activationStatePath(homeDir, account, region))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read activation state: %w", err)
+	}
+
+	var state activationState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("could not parse activation state: %w", err)
+	}
+	return &state, nil
+}
+
+// saveActivationState persists state for account/region, creating the state directory if needed.
+func saveActivationState(homeDir, account, region string, state activationState) error {
+	path := activationStatePath(homeDir, account, region)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("could not create activation state directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode activation state: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("could not write activation state: %w", err)
+	}
+	return nil
+}
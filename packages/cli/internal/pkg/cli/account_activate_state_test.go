@@ -0,0 +1,44 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadActivationState_ReturnsNilWhenNoneExists(t *testing.T) {
+	state, err := loadActivationState(t.TempDir(), "123456789012", "us-west-2")
+
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestSaveAndLoadActivationState_RoundTrips(t *testing.T) {
+	homeDir := t.TempDir()
+	saved := activationState{
+		StackOutputs: map[string]string{"BucketName": "agc-123456789012-us-west-2-abcd1234"},
+		TemplateHash: "deadbeef",
+	}
+
+	require.NoError(t, saveActivationState(homeDir, "123456789012", "us-west-2", saved))
+
+	loaded, err := loadActivationState(homeDir, "123456789012", "us-west-2")
+
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, saved, *loaded)
+}
+
+func TestLoadActivationState_ScopesToAccountAndRegion(t *testing.T) {
+	homeDir := t.TempDir()
+	require.NoError(t, saveActivationState(homeDir, "123456789012", "us-west-2", activationState{TemplateHash: "hash-a"}))
+
+	loaded, err := loadActivationState(homeDir, "123456789012", "eu-west-1")
+
+	require.NoError(t, err)
+	assert.Nil(t, loaded, "state saved for one region must not be visible to another")
+}
@@ -0,0 +1,50 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cdk
+
+import "fmt"
+
+// DiffChangeType describes how DiffApp expects a resource to change on the next deployment.
+type DiffChangeType string
+
+const (
+	DiffChangeCreate  DiffChangeType = "create"
+	DiffChangeModify  DiffChangeType = "modify"
+	DiffChangeDestroy DiffChangeType = "destroy"
+)
+
+// DiffResourceChange is a single resource that would change if the app were deployed.
+type DiffResourceChange struct {
+	LogicalId    string
+	ResourceType string
+	ChangeType   DiffChangeType
+}
+
+// DiffResult is the set of resource changes DiffApp finds between the deployed stacks and the
+// app at path, without making any of those changes.
+type DiffResult struct {
+	StackName    string
+	Changes      []DiffResourceChange
+	TemplateHash string
+}
+
+// String renders the diff as the kind of +/-/~ table `cdk diff` prints, for --show-diff output.
+func (d DiffResult) String() string {
+	if len(d.Changes) == 0 {
+		return fmt.Sprintf("[%s] no changes", d.StackName)
+	}
+
+	out := fmt.Sprintf("[%s]\n", d.StackName)
+	for _, change := range d.Changes {
+		symbol := "~"
+		switch change.ChangeType {
+		case DiffChangeCreate:
+			symbol = "+"
+		case DiffChangeDestroy:
+			symbol = "-"
+		}
+		out += fmt.Sprintf(" %s %s (%s)\n", symbol, change.LogicalId, change.ResourceType)
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffResult_StringWithNoChanges(t *testing.T) {
+	result := DiffResult{StackName: "agc-core"}
+
+	assert.Equal(t, "[agc-core] no changes", result.String())
+}
+
+func TestDiffResult_StringRendersEachChangeWithItsSymbol(t *testing.T) {
+	result := DiffResult{
+		StackName: "agc-core",
+		Changes: []DiffResourceChange{
+			{LogicalId: "Bucket", ResourceType: "AWS::S3::Bucket", ChangeType: DiffChangeCreate},
+			{LogicalId: "Cluster", ResourceType: "AWS::Batch::ComputeEnvironment", ChangeType: DiffChangeModify},
+			{LogicalId: "OldQueue", ResourceType: "AWS::Batch::JobQueue", ChangeType: DiffChangeDestroy},
+		},
+	}
+
+	out := result.String()
+
+	assert.Contains(t, out, "[agc-core]\n")
+	assert.Contains(t, out, "+ Bucket (AWS::S3::Bucket)")
+	assert.Contains(t, out, "~ Cluster (AWS::Batch::ComputeEnvironment)")
+	assert.Contains(t, out, "- OldQueue (AWS::Batch::JobQueue)")
+}
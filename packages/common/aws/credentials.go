@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// CredentialOptions configures how CredentialResolver builds an aws.Config. Profile selects
+// environment, shared-file, or SSO-cached credentials (in that order, matching the default AWS
+// SDK chain plus IMDS as a final fallback); the AssumeRole* fields layer an sts:AssumeRole call
+// on top, for enterprises whose developers only have SSO access to an intermediate account.
+type CredentialOptions struct {
+	Profile       string
+	AssumeRoleArn string
+	ExternalId    string
+	MfaSerial     string
+	Duration      time.Duration
+}
+
+// CredentialResolver composes environment, shared-file, SSO-cache, and IMDS credentials behind
+// a single aws.Config, optionally layering an assumed role on top.
+type CredentialResolver struct {
+	opts CredentialOptions
+}
+
+// NewCredentialResolver returns a CredentialResolver for opts.
+func NewCredentialResolver(opts CredentialOptions) *CredentialResolver {
+	return &CredentialResolver{opts: opts}
+}
+
+// Resolve returns an aws.Config carrying the resolved credentials. The default SDK config
+// loader already walks env vars, shared credentials/config files, and cached SSO tokens
+// (refreshing them via sso:GetRoleCredentials as needed) before falling back to IMDS, so
+// Resolve only needs to add the optional assume-role hop on top.
+func (r *CredentialResolver) Resolve(ctx context.Context) (awssdk.Config, error) {
+	var configOpts []func(*config.LoadOptions) error
+	if r.opts.Profile != "" {
+		configOpts = append(configOpts, config.WithSharedConfigProfile(r.opts.Profile))
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, configOpts...)
+	if err != nil {
+		return awssdk.Config{}, fmt.Errorf("could not load AWS credentials: %w", err)
+	}
+
+	if r.opts.AssumeRoleArn == "" {
+		return cfg, nil
+	}
+
+	stsClient := sts.NewFromConfig(cfg)
+	provider := stscreds.NewAssumeRoleProvider(stsClient, r.opts.AssumeRoleArn, func(o *stscreds.AssumeRoleOptions) {
+		if r.opts.ExternalId != "" {
+			o.ExternalID = awssdk.String(r.opts.ExternalId)
+		}
+		if r.opts.MfaSerial != "" {
+			o.SerialNumber = awssdk.String(r.opts.MfaSerial)
+			o.TokenProvider = stscreds.StdinTokenProvider
+		}
+		if r.opts.Duration > 0 {
+			o.Duration = r.opts.Duration
+		}
+	})
+	cfg.Credentials = awssdk.NewCredentialsCache(provider)
+
+	if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+		return awssdk.Config{}, fmt.Errorf("could not assume role '%s': %w", r.opts.AssumeRoleArn, err)
+	}
+
+	return cfg, nil
+}
+
+// Credentials retrieves the static access key/secret/session token behind the resolved config,
+// for callers (like the CDK subprocess client) that accept credentials as environment variables
+// rather than an aws.Config.
+func (r *CredentialResolver) Credentials(ctx context.Context) (awssdk.Credentials, string, error) {
+	cfg, err := r.Resolve(ctx)
+	if err != nil {
+		return awssdk.Credentials{}, "", err
+	}
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return awssdk.Credentials{}, "", fmt.Errorf("could not retrieve resolved AWS credentials: %w", err)
+	}
+	return creds, cfg.Region, nil
+}
@@ -0,0 +1,79 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Repository identifies an ECR repository that a Mirror has created in the caller's account.
+type Repository struct {
+	RegistryId     string
+	Region         string
+	RepositoryName string
+}
+
+// AuthToken is a short-lived ECR authorization token used to push mirrored images.
+type AuthToken struct {
+	Username string
+	Password string
+	Endpoint string
+}
+
+// MirrorClient is the subset of ECR behavior Mirror needs, kept narrow (rather than the full
+// Interface) so Mirror can be unit-tested against a fake instead of a real ECR client.
+type MirrorClient interface {
+	CreateRepository(repositoryName string) (Repository, error)
+	GetAuthorizationToken() (AuthToken, error)
+	CopyImage(src ImageReference, dest Repository, auth AuthToken) error
+}
+
+// Mirror copies AGC-published images into the caller's own account so activation does not
+// depend on cross-account ECR pull permission. This is required in air-gapped accounts and
+// in regions where AGC has not published images.
+type Mirror struct {
+	client MirrorClient
+}
+
+// NewMirror returns a Mirror that uses client to create repositories and push images in the
+// caller's account.
+func NewMirror(client MirrorClient) *Mirror {
+	return &Mirror{client: client}
+}
+
+// MirrorImages pulls each image in refs, creates a same-named repository in the caller's
+// account if one does not already exist, and pushes the image there. It returns a new set of
+// ImageReferences pointing at the mirrored copies, keyed the same way as refs.
+func (m *Mirror) MirrorImages(refs map[string]ImageReference) (map[string]ImageReference, error) {
+	// The authorization token is registry-wide, not per-repository, so it is fetched once
+	// rather than once per image.
+	auth, err := m.client.GetAuthorizationToken()
+	if err != nil {
+		return nil, fmt.Errorf("could not get ECR authorization token: %w", err)
+	}
+
+	mirrored := make(map[string]ImageReference, len(refs))
+	for key, imageRef := range refs {
+		log.Info().Msgf("Mirroring image %s/%s:%s", imageRef.RegistryId, imageRef.RepositoryName, imageRef.ImageTag)
+
+		repository, err := m.client.CreateRepository(imageRef.RepositoryName)
+		if err != nil {
+			return nil, fmt.Errorf("could not create mirror repository for %s: %w", imageRef.RepositoryName, err)
+		}
+
+		if err := m.client.CopyImage(imageRef, repository, auth); err != nil {
+			return nil, fmt.Errorf("could not mirror image %s: %w", imageRef.RepositoryName, err)
+		}
+
+		mirrored[key] = ImageReference{
+			RegistryId:     repository.RegistryId,
+			Region:         repository.Region,
+			RepositoryName: repository.RepositoryName,
+			ImageTag:       imageRef.ImageTag,
+		}
+	}
+	return mirrored, nil
+}
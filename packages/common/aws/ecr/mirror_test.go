@@ -0,0 +1,108 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ecr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMirrorClient struct {
+	createRepositoryErr error
+	authTokenErr        error
+	copyImageErr        error
+	copiedImages        []string
+	authTokenCalls      int
+}
+
+func (f *fakeMirrorClient) CreateRepository(repositoryName string) (Repository, error) {
+	if f.createRepositoryErr != nil {
+		return Repository{}, f.createRepositoryErr
+	}
+	return Repository{RegistryId: "111111111111", Region: "us-west-2", RepositoryName: repositoryName}, nil
+}
+
+func (f *fakeMirrorClient) GetAuthorizationToken() (AuthToken, error) {
+	f.authTokenCalls++
+	if f.authTokenErr != nil {
+		return AuthToken{}, f.authTokenErr
+	}
+	return AuthToken{Username: "AWS", Password: "token", Endpoint: "111111111111.dkr.ecr.us-west-2.amazonaws.com"}, nil
+}
+
+func (f *fakeMirrorClient) CopyImage(src ImageReference, dest Repository, auth AuthToken) error {
+	if f.copyImageErr != nil {
+		return f.copyImageErr
+	}
+	f.copiedImages = append(f.copiedImages, dest.RepositoryName)
+	return nil
+}
+
+func TestMirrorImages_CopiesEachImageIntoCallerAccount(t *testing.T) {
+	client := &fakeMirrorClient{}
+	refs := map[string]ImageReference{
+		"wes": {RegistryId: "222222222222", Region: "us-east-1", RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+	}
+
+	mirrored, err := NewMirror(client).MirrorImages(refs)
+
+	require.NoError(t, err)
+	require.Contains(t, mirrored, "wes")
+	assert.Equal(t, "111111111111", mirrored["wes"].RegistryId)
+	assert.Equal(t, "us-west-2", mirrored["wes"].Region)
+	assert.Equal(t, "agc-wes", mirrored["wes"].RepositoryName)
+	assert.Equal(t, "1.2.3", mirrored["wes"].ImageTag, "mirroring must keep the original tag")
+	assert.Equal(t, []string{"agc-wes"}, client.copiedImages)
+}
+
+func TestMirrorImages_FetchesAuthorizationTokenOnceForMultipleImages(t *testing.T) {
+	client := &fakeMirrorClient{}
+	refs := map[string]ImageReference{
+		"wes":      {RegistryId: "222222222222", Region: "us-east-1", RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+		"cromwell": {RegistryId: "222222222222", Region: "us-east-1", RepositoryName: "agc-cromwell", ImageTag: "4.5.6"},
+	}
+
+	_, err := NewMirror(client).MirrorImages(refs)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.authTokenCalls, "the registry-wide authorization token should be fetched once, not once per image")
+}
+
+func TestMirrorImages_ReturnsErrorWhenRepositoryCreationFails(t *testing.T) {
+	client := &fakeMirrorClient{createRepositoryErr: errors.New("AccessDenied")}
+	refs := map[string]ImageReference{
+		"wes": {RegistryId: "222222222222", Region: "us-east-1", RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+	}
+
+	_, err := NewMirror(client).MirrorImages(refs)
+
+	require.Error(t, err)
+	assert.Empty(t, client.copiedImages)
+}
+
+func TestMirrorImages_ReturnsErrorWhenAuthorizationTokenFails(t *testing.T) {
+	client := &fakeMirrorClient{authTokenErr: errors.New("AccessDenied")}
+	refs := map[string]ImageReference{
+		"wes": {RegistryId: "222222222222", Region: "us-east-1", RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+	}
+
+	_, err := NewMirror(client).MirrorImages(refs)
+
+	require.Error(t, err)
+	assert.Empty(t, client.copiedImages)
+}
+
+func TestMirrorImages_ReturnsErrorWhenCopyFails(t *testing.T) {
+	client := &fakeMirrorClient{copyImageErr: errors.New("network unreachable")}
+	refs := map[string]ImageReference{
+		"wes": {RegistryId: "222222222222", Region: "us-east-1", RepositoryName: "agc-wes", ImageTag: "1.2.3"},
+	}
+
+	_, err := NewMirror(client).MirrorImages(refs)
+
+	require.Error(t, err)
+}
@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// agcBucketTagKey/Value identify a bucket that AGC provisioned, so Provision can find
+// and reuse an existing bucket instead of creating a new one on every activation.
+const (
+	agcBucketTagKey   = "agc-bucket"
+	agcContextTagKey  = "agc-context"
+	agcBucketTagValue = "true"
+)
+
+// LifecyclePolicy configures the S3 lifecycle rule applied to a provisioned bucket.
+type LifecyclePolicy struct {
+	ExpireDays       int
+	TransitionIADays int
+}
+
+// ProvisionerClient is the subset of S3 behavior BucketProvisioner needs, kept narrow (rather
+// than the full Interface) so BucketProvisioner can be unit-tested without hitting AWS.
+type ProvisionerClient interface {
+	FindTaggedBucket(tags map[string]string) (string, error)
+	CreateBucket(bucketName, region string) error
+	TagBucket(bucketName string, tags map[string]string) error
+	EnableDefaultEncryption(bucketName string) error
+	EnableVersioning(bucketName string) error
+	BlockPublicAccess(bucketName string) error
+	PutLifecycleRule(bucketName string, transitionIADays, expireDays int) error
+}
+
+// BucketProvisioner finds or creates the S3 bucket(s) AGC uses to store its data, so the
+// provisioning logic can be unit-tested without hitting AWS.
+type BucketProvisioner interface {
+	// Provision returns the name of a bucket to use for the given context, creating one if
+	// no AGC-tagged bucket already exists. contextName is empty for the one shared bucket;
+	// `agc account activate --bucket-context` passes a context name to provision a bucket
+	// dedicated to that context instead.
+	Provision(account, region, contextName string, lifecycle LifecyclePolicy) (string, error)
+}
+
+// DefaultBucketName returns the base name BucketProvisioner uses for a newly created bucket,
+// before the random suffix that guarantees global uniqueness is appended. It is exported so
+// callers that only need to preview a bucket name (e.g. `account activate --dry-run`) don't
+// have to provision one to find out what it would be called.
+func DefaultBucketName(account, region string) string {
+	return fmt.Sprintf("agc-%s-%s", account, region)
+}
+
+type bucketProvisioner struct {
+	client ProvisionerClient
+}
+
+// NewBucketProvisioner returns a BucketProvisioner backed by client.
+func NewBucketProvisioner(client ProvisionerClient) BucketProvisioner {
+	return &bucketProvisioner{client: client}
+}
+
+func (p *bucketProvisioner) Provision(account, region, contextName string, lifecycle LifecyclePolicy) (string, error) {
+	tags := map[string]string{agcBucketTagKey: agcBucketTagValue}
+	if contextName != "" {
+		tags[agcContextTagKey] = contextName
+	}
+
+	existing, err := p.client.FindTaggedBucket(tags)
+	if err != nil {
+		return "", fmt.Errorf("could not look up existing AGC bucket: %w", err)
+	}
+	if existing != "" {
+		log.Info().Msgf("Reusing existing AGC bucket '%s'", existing)
+		return existing, nil
+	}
+
+	bucketName := DefaultBucketName(account, region)
+	if contextName != "" {
+		bucketName = fmt.Sprintf("%s-%s", bucketName, contextName)
+	}
+	bucketName = fmt.Sprintf("%s-%s", bucketName, uuid.New().String()[:8])
+
+	log.Info().Msgf("Creating AGC bucket '%s'", bucketName)
+	if err := p.client.CreateBucket(bucketName, region); err != nil {
+		return "", fmt.Errorf("could not create bucket '%s': %w", bucketName, err)
+	}
+	if err := p.client.TagBucket(bucketName, tags); err != nil {
+		return "", fmt.Errorf("could not tag bucket '%s': %w", bucketName, err)
+	}
+	if err := p.client.EnableDefaultEncryption(bucketName); err != nil {
+		return "", fmt.Errorf("could not enable encryption on bucket '%s': %w", bucketName, err)
+	}
+	if err := p.client.EnableVersioning(bucketName); err != nil {
+		return "", fmt.Errorf("could not enable versioning on bucket '%s': %w", bucketName, err)
+	}
+	if err := p.client.BlockPublicAccess(bucketName); err != nil {
+		return "", fmt.Errorf("could not block public access on bucket '%s': %w", bucketName, err)
+	}
+	if lifecycle.ExpireDays > 0 || lifecycle.TransitionIADays > 0 {
+		if err := p.client.PutLifecycleRule(bucketName, lifecycle.TransitionIADays, lifecycle.ExpireDays); err != nil {
+			return "", fmt.Errorf("could not apply lifecycle rule to bucket '%s': %w", bucketName, err)
+		}
+	}
+
+	return bucketName, nil
+}
@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package s3
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvisionerClient struct {
+	taggedBucket     string
+	findTaggedErr    error
+	createBucketErr  error
+	createdBuckets   []string
+	lifecycleApplied bool
+}
+
+func (f *fakeProvisionerClient) FindTaggedBucket(tags map[string]string) (string, error) {
+	return f.taggedBucket, f.findTaggedErr
+}
+
+func (f *fakeProvisionerClient) CreateBucket(bucketName, region string) error {
+	if f.createBucketErr != nil {
+		return f.createBucketErr
+	}
+	f.createdBuckets = append(f.createdBuckets, bucketName)
+	return nil
+}
+
+func (f *fakeProvisionerClient) TagBucket(bucketName string, tags map[string]string) error {
+	return nil
+}
+
+func (f *fakeProvisionerClient) EnableDefaultEncryption(bucketName string) error { return nil }
+func (f *fakeProvisionerClient) EnableVersioning(bucketName string) error        { return nil }
+func (f *fakeProvisionerClient) BlockPublicAccess(bucketName string) error       { return nil }
+
+func (f *fakeProvisionerClient) PutLifecycleRule(bucketName string, transitionIADays, expireDays int) error {
+	f.lifecycleApplied = true
+	return nil
+}
+
+func TestProvision_ReusesExistingTaggedBucket(t *testing.T) {
+	client := &fakeProvisionerClient{taggedBucket: "agc-existing-bucket"}
+
+	bucketName, err := NewBucketProvisioner(client).Provision("123456789012", "us-east-1", "", LifecyclePolicy{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "agc-existing-bucket", bucketName)
+	assert.Empty(t, client.createdBuckets, "must not create a new bucket when one is already tagged")
+}
+
+func TestProvision_CreatesNewBucketWhenNoneTagged(t *testing.T) {
+	client := &fakeProvisionerClient{}
+
+	bucketName, err := NewBucketProvisioner(client).Provision("123456789012", "us-east-1", "", LifecyclePolicy{})
+
+	require.NoError(t, err)
+	require.Len(t, client.createdBuckets, 1)
+	assert.Equal(t, client.createdBuckets[0], bucketName)
+	assert.Contains(t, bucketName, DefaultBucketName("123456789012", "us-east-1"))
+	assert.False(t, client.lifecycleApplied, "no lifecycle rule was requested")
+}
+
+func TestProvision_AppliesLifecycleRuleWhenRequested(t *testing.T) {
+	client := &fakeProvisionerClient{}
+
+	_, err := NewBucketProvisioner(client).Provision("123456789012", "us-east-1", "", LifecyclePolicy{ExpireDays: 30})
+
+	require.NoError(t, err)
+	assert.True(t, client.lifecycleApplied)
+}
+
+func TestProvision_ReturnsErrorWhenLookupFails(t *testing.T) {
+	client := &fakeProvisionerClient{findTaggedErr: errors.New("AccessDenied")}
+
+	_, err := NewBucketProvisioner(client).Provision("123456789012", "us-east-1", "", LifecyclePolicy{})
+
+	require.Error(t, err)
+	assert.Empty(t, client.createdBuckets)
+}
+
+func TestProvision_ScopesToContextWhenGiven(t *testing.T) {
+	client := &fakeProvisionerClient{}
+
+	bucketName, err := NewBucketProvisioner(client).Provision("123456789012", "us-east-1", "my-context", LifecyclePolicy{})
+
+	require.NoError(t, err)
+	assert.Contains(t, bucketName, "my-context")
+}